@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// Generate renders gateway/usecase/controller files for every entity in spec, adds each
+// entity's model to main's AutoMigrate call, and appends a commented-out wiring example for
+// the entity's create endpoint to wiring.SetupWiring. That example is not active code: the
+// generated controllers are not registered on the mux, and do not show up in the OpenAPI
+// publisher, until the wiring is uncommented (and extended for the other operations) by hand.
+func Generate(spec *Spec, projectRoot string) error {
+	for _, entity := range spec.Entities {
+		data := templateData{EntitySpec: entity}
+		for _, f := range entity.Fields {
+			if f.Type == "time.Time" {
+				data.NeedsTime = true
+			}
+		}
+
+		if err := writeGenerated(projectRoot, "gateway", strings.ToLower(entity.Entity)+".go", gatewayTemplate, data); err != nil {
+			return err
+		}
+		if err := writeGenerated(projectRoot, "usecase", strings.ToLower(entity.Entity)+".go", usecaseTemplate, data); err != nil {
+			return err
+		}
+		if err := writeGenerated(projectRoot, "controller", strings.ToLower(entity.Entity)+".go", controllerTemplate, data); err != nil {
+			return err
+		}
+		if err := appendAutoMigrate(projectRoot, entity); err != nil {
+			return err
+		}
+		if err := appendWiringRegistration(projectRoot, entity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// templateData is the data passed to every generated-file template: the entity spec plus
+// a few flags cheaper to compute once in Go than inside the template itself.
+type templateData struct {
+	EntitySpec
+	NeedsTime bool
+}
+
+func writeGenerated(projectRoot, dir, file, tmpl string, data templateData) error {
+	t, err := template.New(file).Funcs(template.FuncMap{
+		"lowerFirst": lowerFirst,
+	}).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("scaffold: parse template for %s: %w", file, err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return fmt.Errorf("scaffold: render template for %s: %w", file, err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		// Emit the unformatted source so the user can see what failed to parse.
+		formatted = buf.Bytes()
+	}
+
+	outDir := filepath.Join(projectRoot, dir)
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return fmt.Errorf("scaffold: create %s: %w", outDir, err)
+	}
+
+	outPath := filepath.Join(outDir, file)
+	if err := os.WriteFile(outPath, formatted, 0o644); err != nil {
+		return fmt.Errorf("scaffold: write %s: %w", outPath, err)
+	}
+
+	fmt.Printf("scaffold: wrote %s\n", outPath)
+
+	return nil
+}
+
+// appendAutoMigrate inserts entity's model into the `db.AutoMigrate(...)` call markers
+// left in main.go.
+func appendAutoMigrate(projectRoot string, entity EntitySpec) error {
+	path := filepath.Join(projectRoot, "main.go")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("scaffold: read %s: %w", path, err)
+	}
+
+	marker := "// Put model to auto migrate here ..."
+	replacement := fmt.Sprintf("gateway.%s{},\n\t%s", entity.Entity, marker)
+
+	content := string(data)
+	if !strings.Contains(content, marker) {
+		return fmt.Errorf("scaffold: could not find AutoMigrate marker in %s", path)
+	}
+	content = strings.ReplaceAll(content, marker, replacement)
+
+	if !strings.Contains(content, `"project/gateway"`) {
+		content = strings.Replace(content, `"project/utils"`, "\"project/gateway\"\n\t\"project/utils\"", 1)
+	}
+
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		// Emit the unformatted source so the user can see what failed to parse.
+		formatted = []byte(content)
+	}
+
+	return os.WriteFile(path, formatted, 0o644)
+}
+
+// appendWiringRegistration appends a commented wiring example for entity to
+// wiring/setup.go, in the same style as the scaffolding comments already there.
+func appendWiringRegistration(projectRoot string, entity EntitySpec) error {
+	path := filepath.Join(projectRoot, "wiring", "setup.go")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("scaffold: read %s: %w", path, err)
+	}
+
+	useCaseArgs := lowerFirst(entity.Entity) + "Gateway"
+	if entity.HasMiddleware("TransactionMiddleware") {
+		useCaseArgs += ", db"
+	}
+
+	block := fmt.Sprintf(`
+	// Generated by cmd/scaffold for %s
+	// %sGateway := gateway.ImplCreate%s(db)
+	// %sUseCase := usecase.ImplCreate%sUseCase(%s)
+	// controller.Create%sController(mux, apiPrinter, %sUseCase)
+`, entity.Entity, lowerFirst(entity.Entity), entity.Entity, lowerFirst(entity.Entity), entity.Entity, useCaseArgs, entity.Entity, lowerFirst(entity.Entity))
+
+	content := string(data)
+	if strings.Contains(content, "Generated by cmd/scaffold for "+entity.Entity) {
+		return nil
+	}
+
+	const anchor = "\n}"
+	idx := strings.LastIndex(content, anchor)
+	if idx < 0 {
+		return fmt.Errorf("scaffold: could not find closing brace in %s", path)
+	}
+	content = content[:idx] + block + content[idx:]
+
+	return os.WriteFile(path, []byte(content), 0o644)
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,58 @@
+// Command scaffold generates gateway/usecase/controller/wiring files for an entity from a
+// small YAML spec, in the spirit of goctl's model generator: point it at a spec (or a
+// CREATE TABLE statement) and get CRUD gateways, use cases and controllers, the model added
+// to main's AutoMigrate call, and a commented-out wiring example in wiring/setup.go. The
+// endpoints are registered, and show up at /api/docs, only once that wiring is uncommented.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	specPath := flag.String("spec", "", "path to a scaffold YAML spec")
+	fromSQL := flag.String("from-sql", "", "path to a .sql file containing a CREATE TABLE statement")
+	projectRoot := flag.String("project-root", ".", "root of the Go module to generate into")
+	flag.Parse()
+
+	if err := run(*specPath, *fromSQL, *projectRoot); err != nil {
+		fmt.Fprintln(os.Stderr, "scaffold:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, fromSQL, projectRoot string) error {
+	var spec *Spec
+
+	switch {
+	case specPath != "" && fromSQL != "":
+		return fmt.Errorf("-spec and -from-sql are mutually exclusive")
+
+	case fromSQL != "":
+		ddl, err := os.ReadFile(fromSQL)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", fromSQL, err)
+		}
+
+		entity, err := ParseCreateTableDDL(string(ddl))
+		if err != nil {
+			return err
+		}
+
+		spec = &Spec{Entities: []EntitySpec{*entity}}
+
+	case specPath != "":
+		loaded, err := LoadSpec(specPath)
+		if err != nil {
+			return err
+		}
+		spec = loaded
+
+	default:
+		return fmt.Errorf("one of -spec or -from-sql is required")
+	}
+
+	return Generate(spec, projectRoot)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FieldSpec describes one column/field of an entity.
+type FieldSpec struct {
+	Name string `yaml:"name"`
+	Type string `yaml:"type"`
+	Gorm string `yaml:"gorm,omitempty"`
+	JSON string `yaml:"json,omitempty"`
+}
+
+// EntitySpec describes one entity to scaffold: its persisted model, the CRUD operations
+// to generate, and the middleware stack each generated use case should be wrapped in.
+type EntitySpec struct {
+	Entity     string      `yaml:"entity"`
+	Table      string      `yaml:"table"`
+	Fields     []FieldSpec `yaml:"fields"`
+	Operations []string    `yaml:"operations"`
+	Middleware []string    `yaml:"middleware"`
+}
+
+// Spec is the top-level scaffold file: a list of entities to generate.
+type Spec struct {
+	Entities []EntitySpec `yaml:"entities"`
+}
+
+// LoadSpec reads a scaffold spec file from path and fills in each entity's defaults with
+// normalizeEntitySpec; the spec's contents are not otherwise validated.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("scaffold: read spec %s: %w", path, err)
+	}
+
+	var spec Spec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("scaffold: parse spec %s: %w", path, err)
+	}
+
+	for i := range spec.Entities {
+		normalizeEntitySpec(&spec.Entities[i])
+	}
+
+	return &spec, nil
+}
+
+func normalizeEntitySpec(e *EntitySpec) {
+	if e.Table == "" {
+		e.Table = strings.ToLower(e.Entity) + "s"
+	}
+	if len(e.Operations) == 0 {
+		e.Operations = []string{"create", "get", "list", "update", "delete"}
+	}
+	if len(e.Middleware) == 0 {
+		e.Middleware = []string{"Logging", "Timing"}
+	}
+
+	fields := e.Fields[:0]
+	for _, f := range e.Fields {
+		// The generated model already declares its own primary-key ID field; drop a
+		// user-supplied one instead of emitting a duplicate field and failing to compile.
+		if strings.EqualFold(f.Name, "id") {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	e.Fields = fields
+
+	for i := range e.Fields {
+		if e.Fields[i].JSON == "" {
+			e.Fields[i].JSON = strings.ToLower(e.Fields[i].Name[:1]) + e.Fields[i].Name[1:]
+		}
+	}
+}
+
+// HasOp reports whether operation op is enabled for this entity.
+func (e EntitySpec) HasOp(op string) bool {
+	for _, o := range e.Operations {
+		if strings.EqualFold(o, op) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMiddleware reports whether name is present in this entity's configured
+// Middleware stack.
+func (e EntitySpec) HasMiddleware(name string) bool {
+	for _, m := range e.Middleware {
+		if strings.EqualFold(m, name) {
+			return true
+		}
+	}
+	return false
+}
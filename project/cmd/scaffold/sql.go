@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var createTableRe = regexp.MustCompile(`(?is)CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?` + "`" + `?(\w+)` + "`" + `?\s*\((.*)\)\s*;?\s*$`)
+
+// sqlTypeToGoType maps common SQL column types to the Go type used on the generated model.
+var sqlTypeToGoType = map[string]string{
+	"int":       "int",
+	"integer":   "int",
+	"bigint":    "int64",
+	"smallint":  "int16",
+	"tinyint":   "int8",
+	"float":     "float32",
+	"double":    "float64",
+	"decimal":   "float64",
+	"numeric":   "float64",
+	"boolean":   "bool",
+	"bool":      "bool",
+	"varchar":   "string",
+	"char":      "string",
+	"text":      "string",
+	"date":      "time.Time",
+	"datetime":  "time.Time",
+	"timestamp": "time.Time",
+}
+
+// ParseCreateTableDDL derives an EntitySpec from a single `CREATE TABLE` statement,
+// mirroring goctl's `model` workflow of deriving entities from existing schema.
+func ParseCreateTableDDL(ddl string) (*EntitySpec, error) {
+	match := createTableRe.FindStringSubmatch(strings.TrimSpace(ddl))
+	if match == nil {
+		return nil, fmt.Errorf("scaffold: could not find a CREATE TABLE statement")
+	}
+
+	table := match[1]
+	entity := toPascalCase(strings.TrimSuffix(table, "s"))
+
+	spec := &EntitySpec{Entity: entity, Table: table}
+
+	for _, rawCol := range splitColumnDefs(match[2]) {
+		col := strings.Fields(rawCol)
+		if len(col) < 2 {
+			continue
+		}
+
+		name := strings.Trim(col[0], "`\"")
+		if strings.EqualFold(name, "PRIMARY") || strings.EqualFold(name, "KEY") ||
+			strings.EqualFold(name, "CONSTRAINT") || strings.EqualFold(name, "FOREIGN") {
+			continue
+		}
+		if strings.EqualFold(name, "id") {
+			continue
+		}
+
+		sqlType := strings.ToLower(baseSQLType(col[1]))
+		goType, ok := sqlTypeToGoType[sqlType]
+		if !ok {
+			goType = "string"
+		}
+
+		spec.Fields = append(spec.Fields, FieldSpec{
+			Name: toPascalCase(name),
+			Type: goType,
+			JSON: toCamelCase(name),
+		})
+	}
+
+	normalizeEntitySpec(spec)
+
+	return spec, nil
+}
+
+// splitColumnDefs splits a CREATE TABLE column list on top-level commas, ignoring commas
+// nested inside parentheses (e.g. `DECIMAL(10,2)`).
+func splitColumnDefs(body string) []string {
+	var defs []string
+	depth := 0
+	start := 0
+
+	for i, r := range body {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				defs = append(defs, body[start:i])
+				start = i + 1
+			}
+		}
+	}
+	defs = append(defs, body[start:])
+
+	return defs
+}
+
+// baseSQLType strips a type's size/precision, e.g. `VARCHAR(255)` -> `VARCHAR`.
+func baseSQLType(t string) string {
+	if i := strings.IndexByte(t, '('); i >= 0 {
+		return t[:i]
+	}
+	return t
+}
+
+func toPascalCase(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' })
+	for i, p := range parts {
+		if p == "" {
+			continue
+		}
+		parts[i] = strings.ToUpper(p[:1]) + p[1:]
+	}
+	return strings.Join(parts, "")
+}
+
+func toCamelCase(s string) string {
+	pascal := toPascalCase(s)
+	if pascal == "" {
+		return pascal
+	}
+	return strings.ToLower(pascal[:1]) + pascal[1:]
+}
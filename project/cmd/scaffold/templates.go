@@ -0,0 +1,427 @@
+package main
+
+// gatewayTemplate renders gateway/<entity>.go: the persisted model plus one
+// core.ActionHandler-shaped gateway per requested CRUD operation, reading any active
+// transaction out of the context the same way TransactionMiddleware puts it in.
+const gatewayTemplate = `// Code generated by cmd/scaffold. DO NOT EDIT.
+package gateway
+
+import (
+	"context"
+	"project/core"
+	"project/middleware"
+{{if .NeedsTime}}	"time"
+{{end}}
+	"gorm.io/gorm"
+)
+
+// {{.Entity}} is the persisted model for the {{.Table}} table.
+type {{.Entity}} struct {
+	ID uint ` + "`gorm:\"primaryKey\" json:\"id\"`" + `
+{{range .Fields}}	{{.Name}} {{.Type}} ` + "`" + `gorm:"{{.Gorm}}" json:"{{.JSON}}"` + "`" + `
+{{end}}}
+
+{{if .HasOp "create"}}
+// Create{{.Entity}}Req is the request for creating a {{.Entity}}.
+type Create{{.Entity}}Req struct {
+{{range .Fields}}	{{.Name}} {{.Type}} ` + "`" + `json:"{{.JSON}}"` + "`" + `
+{{end}}}
+
+// Create{{.Entity}}Res is the response for creating a {{.Entity}}.
+type Create{{.Entity}}Res struct {
+	{{.Entity}} {{.Entity}}
+}
+
+// Create{{.Entity}} is the gateway for persisting a new {{.Entity}}.
+type Create{{.Entity}} = core.ActionHandler[Create{{.Entity}}Req, Create{{.Entity}}Res]
+
+// ImplCreate{{.Entity}} implements the Create{{.Entity}} gateway.
+func ImplCreate{{.Entity}}(db *gorm.DB) Create{{.Entity}} {
+	return func(ctx context.Context, req Create{{.Entity}}Req) (*Create{{.Entity}}Res, error) {
+		model := {{.Entity}}{
+{{range .Fields}}			{{.Name}}: req.{{.Name}},
+{{end}}		}
+
+		tx := middleware.GetDBFromContext(ctx, db)
+		if err := tx.WithContext(ctx).Create(&model).Error; err != nil {
+			return nil, err
+		}
+
+		return &Create{{.Entity}}Res{ {{.Entity}}: model}, nil
+	}
+}
+{{end}}
+
+{{if .HasOp "get"}}
+// Get{{.Entity}}Req is the request for fetching a single {{.Entity}} by id.
+type Get{{.Entity}}Req struct {
+	ID uint
+}
+
+// Get{{.Entity}}Res is the response for fetching a single {{.Entity}}.
+type Get{{.Entity}}Res struct {
+	{{.Entity}} {{.Entity}}
+}
+
+// Get{{.Entity}} is the gateway for fetching a single {{.Entity}} by id.
+type Get{{.Entity}} = core.ActionHandler[Get{{.Entity}}Req, Get{{.Entity}}Res]
+
+// ImplGet{{.Entity}} implements the Get{{.Entity}} gateway.
+func ImplGet{{.Entity}}(db *gorm.DB) Get{{.Entity}} {
+	return func(ctx context.Context, req Get{{.Entity}}Req) (*Get{{.Entity}}Res, error) {
+		var model {{.Entity}}
+
+		tx := middleware.GetDBFromContext(ctx, db)
+		if err := tx.WithContext(ctx).First(&model, req.ID).Error; err != nil {
+			return nil, err
+		}
+
+		return &Get{{.Entity}}Res{ {{.Entity}}: model}, nil
+	}
+}
+{{end}}
+
+{{if .HasOp "list"}}
+// List{{.Entity}}Req is the request for listing {{.Entity}} records.
+type List{{.Entity}}Req struct{}
+
+// List{{.Entity}}Res is the response for listing {{.Entity}} records.
+type List{{.Entity}}Res struct {
+	{{.Entity}}s []{{.Entity}}
+}
+
+// List{{.Entity}} is the gateway for listing {{.Entity}} records.
+type List{{.Entity}} = core.ActionHandler[List{{.Entity}}Req, List{{.Entity}}Res]
+
+// ImplList{{.Entity}} implements the List{{.Entity}} gateway.
+func ImplList{{.Entity}}(db *gorm.DB) List{{.Entity}} {
+	return func(ctx context.Context, req List{{.Entity}}Req) (*List{{.Entity}}Res, error) {
+		var models []{{.Entity}}
+
+		tx := middleware.GetDBFromContext(ctx, db)
+		if err := tx.WithContext(ctx).Find(&models).Error; err != nil {
+			return nil, err
+		}
+
+		return &List{{.Entity}}Res{ {{.Entity}}s: models}, nil
+	}
+}
+{{end}}
+
+{{if .HasOp "update"}}
+// Update{{.Entity}}Req is the request for updating a {{.Entity}}.
+type Update{{.Entity}}Req struct {
+	ID uint
+{{range .Fields}}	{{.Name}} {{.Type}} ` + "`" + `json:"{{.JSON}}"` + "`" + `
+{{end}}}
+
+// Update{{.Entity}}Res is the response for updating a {{.Entity}}.
+type Update{{.Entity}}Res struct {
+	{{.Entity}} {{.Entity}}
+}
+
+// Update{{.Entity}} is the gateway for updating a {{.Entity}}.
+type Update{{.Entity}} = core.ActionHandler[Update{{.Entity}}Req, Update{{.Entity}}Res]
+
+// ImplUpdate{{.Entity}} implements the Update{{.Entity}} gateway.
+func ImplUpdate{{.Entity}}(db *gorm.DB) Update{{.Entity}} {
+	return func(ctx context.Context, req Update{{.Entity}}Req) (*Update{{.Entity}}Res, error) {
+		tx := middleware.GetDBFromContext(ctx, db)
+
+		var model {{.Entity}}
+		if err := tx.WithContext(ctx).First(&model, req.ID).Error; err != nil {
+			return nil, err
+		}
+
+{{range .Fields}}		model.{{.Name}} = req.{{.Name}}
+{{end}}
+		if err := tx.WithContext(ctx).Save(&model).Error; err != nil {
+			return nil, err
+		}
+
+		return &Update{{.Entity}}Res{ {{.Entity}}: model}, nil
+	}
+}
+{{end}}
+
+{{if .HasOp "delete"}}
+// Delete{{.Entity}}Req is the request for deleting a {{.Entity}} by id.
+type Delete{{.Entity}}Req struct {
+	ID uint
+}
+
+// Delete{{.Entity}}Res is the response for deleting a {{.Entity}}.
+type Delete{{.Entity}}Res struct{}
+
+// Delete{{.Entity}} is the gateway for deleting a {{.Entity}} by id.
+type Delete{{.Entity}} = core.ActionHandler[Delete{{.Entity}}Req, Delete{{.Entity}}Res]
+
+// ImplDelete{{.Entity}} implements the Delete{{.Entity}} gateway.
+func ImplDelete{{.Entity}}(db *gorm.DB) Delete{{.Entity}} {
+	return func(ctx context.Context, req Delete{{.Entity}}Req) (*Delete{{.Entity}}Res, error) {
+		tx := middleware.GetDBFromContext(ctx, db)
+		if err := tx.WithContext(ctx).Delete(&{{.Entity}}{}, req.ID).Error; err != nil {
+			return nil, err
+		}
+
+		return &Delete{{.Entity}}Res{}, nil
+	}
+}
+{{end}}
+`
+
+// usecaseTemplate renders usecase/<entity>.go: a thin pass-through use case per operation
+// so controllers never call gateways directly, matching the layering the rest of the repo
+// documents in its commented wiring examples.
+const usecaseTemplate = `// Code generated by cmd/scaffold. DO NOT EDIT.
+package usecase
+
+import (
+	"project/gateway"
+	"project/middleware"
+{{if .HasMiddleware "TransactionMiddleware"}}
+	"gorm.io/gorm"
+{{end}}
+)
+
+{{if .HasOp "create"}}
+// Create{{.Entity}}UseCase is the use case for creating a {{.Entity}}.
+type Create{{.Entity}}UseCase = gateway.Create{{.Entity}}
+
+// ImplCreate{{.Entity}}UseCase implements the Create{{.Entity}}UseCase, wrapping
+// create{{.Entity}} in this entity's configured middleware stack.
+func ImplCreate{{.Entity}}UseCase(create{{.Entity}} gateway.Create{{.Entity}}{{if .HasMiddleware "TransactionMiddleware"}}, db *gorm.DB{{end}}) Create{{.Entity}}UseCase {
+	handler := create{{.Entity}}
+{{if .HasMiddleware "TransactionMiddleware"}}	handler = middleware.TransactionMiddleware(handler, db)
+{{end}}{{if .HasMiddleware "Timing"}}	handler = middleware.Timing(handler, "Create{{.Entity}}")
+{{end}}{{if .HasMiddleware "Retry"}}	handler = middleware.Retry(handler, 3)
+{{end}}{{if .HasMiddleware "Logging"}}	handler = middleware.Logging(handler, 0)
+{{end}}	return handler
+}
+{{end}}
+
+{{if .HasOp "get"}}
+// Get{{.Entity}}UseCase is the use case for fetching a {{.Entity}}.
+type Get{{.Entity}}UseCase = gateway.Get{{.Entity}}
+
+// ImplGet{{.Entity}}UseCase implements the Get{{.Entity}}UseCase, wrapping
+// get{{.Entity}} in this entity's configured middleware stack.
+func ImplGet{{.Entity}}UseCase(get{{.Entity}} gateway.Get{{.Entity}}{{if .HasMiddleware "TransactionMiddleware"}}, db *gorm.DB{{end}}) Get{{.Entity}}UseCase {
+	handler := get{{.Entity}}
+{{if .HasMiddleware "TransactionMiddleware"}}	handler = middleware.TransactionMiddleware(handler, db)
+{{end}}{{if .HasMiddleware "Timing"}}	handler = middleware.Timing(handler, "Get{{.Entity}}")
+{{end}}{{if .HasMiddleware "Retry"}}	handler = middleware.Retry(handler, 3)
+{{end}}{{if .HasMiddleware "Logging"}}	handler = middleware.Logging(handler, 0)
+{{end}}	return handler
+}
+{{end}}
+
+{{if .HasOp "list"}}
+// List{{.Entity}}UseCase is the use case for listing {{.Entity}} records.
+type List{{.Entity}}UseCase = gateway.List{{.Entity}}
+
+// ImplList{{.Entity}}UseCase implements the List{{.Entity}}UseCase, wrapping
+// list{{.Entity}} in this entity's configured middleware stack.
+func ImplList{{.Entity}}UseCase(list{{.Entity}} gateway.List{{.Entity}}{{if .HasMiddleware "TransactionMiddleware"}}, db *gorm.DB{{end}}) List{{.Entity}}UseCase {
+	handler := list{{.Entity}}
+{{if .HasMiddleware "TransactionMiddleware"}}	handler = middleware.TransactionMiddleware(handler, db)
+{{end}}{{if .HasMiddleware "Timing"}}	handler = middleware.Timing(handler, "List{{.Entity}}")
+{{end}}{{if .HasMiddleware "Retry"}}	handler = middleware.Retry(handler, 3)
+{{end}}{{if .HasMiddleware "Logging"}}	handler = middleware.Logging(handler, 0)
+{{end}}	return handler
+}
+{{end}}
+
+{{if .HasOp "update"}}
+// Update{{.Entity}}UseCase is the use case for updating a {{.Entity}}.
+type Update{{.Entity}}UseCase = gateway.Update{{.Entity}}
+
+// ImplUpdate{{.Entity}}UseCase implements the Update{{.Entity}}UseCase, wrapping
+// update{{.Entity}} in this entity's configured middleware stack.
+func ImplUpdate{{.Entity}}UseCase(update{{.Entity}} gateway.Update{{.Entity}}{{if .HasMiddleware "TransactionMiddleware"}}, db *gorm.DB{{end}}) Update{{.Entity}}UseCase {
+	handler := update{{.Entity}}
+{{if .HasMiddleware "TransactionMiddleware"}}	handler = middleware.TransactionMiddleware(handler, db)
+{{end}}{{if .HasMiddleware "Timing"}}	handler = middleware.Timing(handler, "Update{{.Entity}}")
+{{end}}{{if .HasMiddleware "Retry"}}	handler = middleware.Retry(handler, 3)
+{{end}}{{if .HasMiddleware "Logging"}}	handler = middleware.Logging(handler, 0)
+{{end}}	return handler
+}
+{{end}}
+
+{{if .HasOp "delete"}}
+// Delete{{.Entity}}UseCase is the use case for deleting a {{.Entity}}.
+type Delete{{.Entity}}UseCase = gateway.Delete{{.Entity}}
+
+// ImplDelete{{.Entity}}UseCase implements the Delete{{.Entity}}UseCase, wrapping
+// delete{{.Entity}} in this entity's configured middleware stack.
+func ImplDelete{{.Entity}}UseCase(delete{{.Entity}} gateway.Delete{{.Entity}}{{if .HasMiddleware "TransactionMiddleware"}}, db *gorm.DB{{end}}) Delete{{.Entity}}UseCase {
+	handler := delete{{.Entity}}
+{{if .HasMiddleware "TransactionMiddleware"}}	handler = middleware.TransactionMiddleware(handler, db)
+{{end}}{{if .HasMiddleware "Timing"}}	handler = middleware.Timing(handler, "Delete{{.Entity}}")
+{{end}}{{if .HasMiddleware "Retry"}}	handler = middleware.Retry(handler, 3)
+{{end}}{{if .HasMiddleware "Logging"}}	handler = middleware.Logging(handler, 0)
+{{end}}	return handler
+}
+{{end}}
+`
+
+// controllerTemplate renders controller/<entity>.go: one HTTP endpoint per requested
+// operation, each registered with the ApiPrinter so it shows up at /api/docs.
+const controllerTemplate = `// Code generated by cmd/scaffold. DO NOT EDIT.
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"project/gateway"
+	"project/usecase"
+	"project/utils"
+{{if or (.HasOp "get") (.HasOp "update") (.HasOp "delete")}}	"strconv"
+{{end}})
+
+{{if .HasOp "create"}}
+// Create{{.Entity}}Controller registers the POST /{{.Table}} endpoint.
+func Create{{.Entity}}Controller(mux *http.ServeMux, apiPrinter *utils.ApiPrinter, useCase usecase.Create{{.Entity}}UseCase) {
+	apiPrinter.Add(utils.APIData{
+		Method:  "POST",
+		Url:     "/{{.Table}}",
+		Tag:     "{{.Entity}}",
+		Summary: "Create a {{.Entity}}",
+	})
+
+	mux.HandleFunc("POST /{{.Table}}", func(w http.ResponseWriter, r *http.Request) {
+		var req gateway.Create{{.Entity}}Req
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		res, err := useCase(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	})
+}
+{{end}}
+
+{{if .HasOp "get"}}
+// Get{{.Entity}}Controller registers the GET /{{.Table}}/{id} endpoint.
+func Get{{.Entity}}Controller(mux *http.ServeMux, apiPrinter *utils.ApiPrinter, useCase usecase.Get{{.Entity}}UseCase) {
+	apiPrinter.Add(utils.APIData{
+		Method:  "GET",
+		Url:     "/{{.Table}}/{id}",
+		Tag:     "{{.Entity}}",
+		Summary: "Get a {{.Entity}} by id",
+	})
+
+	mux.HandleFunc("GET /{{.Table}}/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid id", http.StatusBadRequest)
+			return
+		}
+
+		res, err := useCase(r.Context(), gateway.Get{{.Entity}}Req{ID: uint(id)})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	})
+}
+{{end}}
+
+{{if .HasOp "list"}}
+// List{{.Entity}}Controller registers the GET /{{.Table}} endpoint.
+func List{{.Entity}}Controller(mux *http.ServeMux, apiPrinter *utils.ApiPrinter, useCase usecase.List{{.Entity}}UseCase) {
+	apiPrinter.Add(utils.APIData{
+		Method:  "GET",
+		Url:     "/{{.Table}}",
+		Tag:     "{{.Entity}}",
+		Summary: "List {{.Entity}} records",
+	})
+
+	mux.HandleFunc("GET /{{.Table}}", func(w http.ResponseWriter, r *http.Request) {
+		res, err := useCase(r.Context(), gateway.List{{.Entity}}Req{})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	})
+}
+{{end}}
+
+{{if .HasOp "update"}}
+// Update{{.Entity}}Controller registers the PUT /{{.Table}}/{id} endpoint.
+func Update{{.Entity}}Controller(mux *http.ServeMux, apiPrinter *utils.ApiPrinter, useCase usecase.Update{{.Entity}}UseCase) {
+	apiPrinter.Add(utils.APIData{
+		Method:  "PUT",
+		Url:     "/{{.Table}}/{id}",
+		Tag:     "{{.Entity}}",
+		Summary: "Update a {{.Entity}}",
+	})
+
+	mux.HandleFunc("PUT /{{.Table}}/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid id", http.StatusBadRequest)
+			return
+		}
+
+		var req gateway.Update{{.Entity}}Req
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.ID = uint(id)
+
+		res, err := useCase(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	})
+}
+{{end}}
+
+{{if .HasOp "delete"}}
+// Delete{{.Entity}}Controller registers the DELETE /{{.Table}}/{id} endpoint.
+func Delete{{.Entity}}Controller(mux *http.ServeMux, apiPrinter *utils.ApiPrinter, useCase usecase.Delete{{.Entity}}UseCase) {
+	apiPrinter.Add(utils.APIData{
+		Method:  "DELETE",
+		Url:     "/{{.Table}}/{id}",
+		Tag:     "{{.Entity}}",
+		Summary: "Delete a {{.Entity}}",
+	})
+
+	mux.HandleFunc("DELETE /{{.Table}}/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid id", http.StatusBadRequest)
+			return
+		}
+
+		res, err := useCase(r.Context(), gateway.Delete{{.Entity}}Req{ID: uint(id)})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	})
+}
+{{end}}
+`
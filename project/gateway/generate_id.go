@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"project/core"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"github.com/rs/xid"
+)
+
+// IDKind selects which identifier generation strategy GenerateID should use for a call.
+type IDKind string
+
+const (
+	IDKindUUIDv4    IDKind = "uuid4"
+	IDKindUUIDv7    IDKind = "uuid7"
+	IDKindXID       IDKind = "xid"
+	IDKindULID      IDKind = "ulid"
+	IDKindSnowflake IDKind = "snowflake"
+)
+
+// GenerateIDReq is the request for generating an identifier of a specific Kind, e.g. xid
+// for short URL slugs or uuid for external API surfaces.
+type GenerateIDReq struct {
+	Kind IDKind
+}
+
+// GenerateIDRes is the response carrying the generated identifier.
+type GenerateIDRes struct {
+	ID string
+}
+
+// GenerateID is the gateway for generating an identifier.
+type GenerateID = core.ActionHandler[GenerateIDReq, GenerateIDRes]
+
+// ImplGenerateIDComposite dispatches each call to the backend registered for the request's
+// Kind, so a single gateway instance can serve several identifier styles side by side.
+func ImplGenerateIDComposite(backends map[IDKind]GenerateID) GenerateID {
+	return func(ctx context.Context, req GenerateIDReq) (*GenerateIDRes, error) {
+		backend, ok := backends[req.Kind]
+		if !ok {
+			return nil, fmt.Errorf("gateway: no GenerateID backend registered for kind %q", req.Kind)
+		}
+		return backend(ctx, req)
+	}
+}
+
+// ImplGenerateIDUUIDv4 implements GenerateID with a random UUIDv4.
+func ImplGenerateIDUUIDv4() GenerateID {
+	return func(ctx context.Context, req GenerateIDReq) (*GenerateIDRes, error) {
+		return &GenerateIDRes{ID: uuid.New().String()}, nil
+	}
+}
+
+// ImplGenerateIDUUIDv7 implements GenerateID with a time-ordered UUIDv7.
+func ImplGenerateIDUUIDv7() GenerateID {
+	return func(ctx context.Context, req GenerateIDReq) (*GenerateIDRes, error) {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("gateway: generate uuidv7: %w", err)
+		}
+		return &GenerateIDRes{ID: id.String()}, nil
+	}
+}
+
+// ImplGenerateIDXID implements GenerateID with a 12-byte, sortable, mongo-style xid.
+func ImplGenerateIDXID() GenerateID {
+	return func(ctx context.Context, req GenerateIDReq) (*GenerateIDRes, error) {
+		return &GenerateIDRes{ID: xid.New().String()}, nil
+	}
+}
+
+// ImplGenerateIDULID implements GenerateID with a monotonic ULID.
+func ImplGenerateIDULID() GenerateID {
+	var mu sync.Mutex
+	entropy := ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0)
+
+	return func(ctx context.Context, req GenerateIDReq) (*GenerateIDRes, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		id, err := ulid.New(ulid.Timestamp(time.Now()), entropy)
+		if err != nil {
+			return nil, fmt.Errorf("gateway: generate ulid: %w", err)
+		}
+		return &GenerateIDRes{ID: id.String()}, nil
+	}
+}
+
+// snowflakeGenerator is a minimal monotonic Snowflake-style generator: 41 bits of
+// millisecond timestamp since snowflakeEpoch, 10 bits of node id, 12 bits of
+// per-millisecond sequence.
+type snowflakeGenerator struct {
+	mu       sync.Mutex
+	nodeID   int64
+	lastMs   int64
+	sequence int64
+}
+
+var snowflakeEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+
+func (g *snowflakeGenerator) next() int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now().UnixMilli()
+	if now < g.lastMs {
+		// The wall clock stepped back: keep counting in the last issued millisecond.
+		now = g.lastMs
+	}
+	if now == g.lastMs {
+		g.sequence = (g.sequence + 1) & 0xFFF
+		if g.sequence == 0 {
+			for now <= g.lastMs {
+				now = time.Now().UnixMilli()
+			}
+		}
+	} else {
+		g.sequence = 0
+	}
+	g.lastMs = now
+
+	return (now-snowflakeEpoch)<<22 | g.nodeID<<12 | g.sequence
+}
+
+// ImplGenerateIDSnowflake implements GenerateID with a monotonic Snowflake-style 64-bit id,
+// seeded with a node id read from config so multiple instances don't collide.
+func ImplGenerateIDSnowflake(nodeID int64) GenerateID {
+	gen := &snowflakeGenerator{nodeID: nodeID & 0x3FF}
+
+	return func(ctx context.Context, req GenerateIDReq) (*GenerateIDRes, error) {
+		return &GenerateIDRes{ID: strconv.FormatInt(gen.next(), 10)}, nil
+	}
+}
+
+// GenerateUUIDReq is the request for generating a UUID.
+//
+// Deprecated: prefer GenerateIDReq{Kind: IDKindUUIDv4}; kept for backward compatibility.
+type GenerateUUIDReq struct{}
+
+// GenerateUUIDRes is the response for generating a UUID.
+//
+// Deprecated: prefer GenerateIDRes; kept for backward compatibility.
+type GenerateUUIDRes struct {
+	UUID string
+}
+
+// GenerateUUID is the gateway for generating a UUID.
+//
+// Deprecated: prefer GenerateID; kept for backward compatibility.
+type GenerateUUID = core.ActionHandler[GenerateUUIDReq, GenerateUUIDRes]
+
+// ImplGenerateUUID implements the GenerateUUID gateway on top of ImplGenerateIDUUIDv4.
+//
+// Deprecated: prefer ImplGenerateIDComposite with IDKindUUIDv4; kept for backward
+// compatibility.
+func ImplGenerateUUID() GenerateUUID {
+	generateID := ImplGenerateIDUUIDv4()
+
+	return func(ctx context.Context, req GenerateUUIDReq) (*GenerateUUIDRes, error) {
+		res, err := generateID(ctx, GenerateIDReq{Kind: IDKindUUIDv4})
+		if err != nil {
+			return nil, err
+		}
+		return &GenerateUUIDRes{UUID: res.ID}, nil
+	}
+}
@@ -4,30 +4,8 @@ import (
 	"context"
 	"project/core"
 	"time"
-
-	"github.com/google/uuid"
 )
 
-// GenerateUUIDReq is the request for generating a UUID
-type GenerateUUIDReq struct{}
-
-// GenerateUUIDRes is the response for generating a UUID
-type GenerateUUIDRes struct {
-	UUID string
-}
-
-// GenerateUUID is the gateway for generating a UUID
-type GenerateUUID = core.ActionHandler[GenerateUUIDReq, GenerateUUIDRes]
-
-// ImplGenerateUUID implements the GenerateUUID gateway
-func ImplGenerateUUID() GenerateUUID {
-	return func(ctx context.Context, req GenerateUUIDReq) (*GenerateUUIDRes, error) {
-		return &GenerateUUIDRes{
-			UUID: uuid.New().String(),
-		}, nil
-	}
-}
-
 // GetCurrentTimeReq is the request for getting the current time
 type GetCurrentTimeReq struct{}
 
@@ -32,9 +32,13 @@ func main() {
 	// Create API printer for documentation
 	apiPrinter := utils.NewApiPrinter()
 
-	wiring.SetupWiring(mux, db, apiPrinter)
+	// Create WebSocket route printer for documentation
+	wsRoutePrinter := utils.NewWSRoutePrinter()
+
+	wiring.SetupWiring(mux, db, apiPrinter, wsRoutePrinter)
 
 	apiPrinter.PublishAPI(mux, "http://localhost:8080", "/api/docs")
+	wsRoutePrinter.PublishWSDocs(mux, "http://localhost:8080", "/api/ws-docs")
 
 	// Start the server
 	port := 8080
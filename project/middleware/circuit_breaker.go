@@ -0,0 +1,221 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"project/core"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned instead of invoking the wrapped handler while a
+// CircuitBreaker is in its open state.
+var ErrCircuitOpen = errors.New("middleware: circuit breaker is open")
+
+// CBState is one of the three states a CircuitBreaker can be in.
+type CBState int
+
+const (
+	CBClosed CBState = iota
+	CBOpen
+	CBHalfOpen
+)
+
+// CBConfig configures a CircuitBreaker.
+type CBConfig struct {
+	// FailureThreshold is the number of failures within Window that trips the breaker
+	// from closed to open.
+	FailureThreshold int
+	// Window is the rolling window over which FailureThreshold is evaluated.
+	Window time.Duration
+	// CoolDown is how long the breaker stays open before allowing a half-open probe.
+	CoolDown time.Duration
+	// SuccessThreshold is the number of consecutive successes in half-open required to
+	// close the breaker again.
+	SuccessThreshold int
+}
+
+// CBStats is a snapshot of a CircuitBreaker's state, returned by Stats().
+type CBStats struct {
+	State          CBState
+	FailureCount   int
+	SuccessCount   int
+	LastTransition time.Time
+}
+
+type outcome struct {
+	at      time.Time
+	success bool
+}
+
+// circuitBreaker is the per-key state machine backing CircuitBreaker.
+type circuitBreaker struct {
+	cfg CBConfig
+
+	mu             sync.Mutex
+	state          CBState
+	outcomes       []outcome
+	successCount   int
+	lastTransition time.Time
+}
+
+func newCircuitBreaker(cfg CBConfig) *circuitBreaker {
+	return &circuitBreaker{cfg: cfg, lastTransition: time.Now()}
+}
+
+func (cb *circuitBreaker) transitionTo(state CBState) {
+	cb.state = state
+	cb.lastTransition = time.Now()
+	cb.outcomes = cb.outcomes[:0]
+	cb.successCount = 0
+}
+
+// allow reports whether a call may proceed, first moving an open breaker to half-open
+// once its cool-down has elapsed.
+func (cb *circuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CBOpen && time.Since(cb.lastTransition) >= cb.cfg.CoolDown {
+		cb.transitionTo(CBHalfOpen)
+	}
+
+	return cb.state != CBOpen
+}
+
+func (cb *circuitBreaker) recordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CBHalfOpen {
+		cb.transitionTo(CBOpen)
+		return
+	}
+
+	now := time.Now()
+	cb.outcomes = append(cb.outcomes, outcome{at: now, success: false})
+	cb.outcomes = pruneOutcomes(cb.outcomes, now, cb.cfg.Window)
+
+	if countFailures(cb.outcomes) >= cb.cfg.FailureThreshold {
+		cb.transitionTo(CBOpen)
+	}
+}
+
+func (cb *circuitBreaker) recordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CBHalfOpen {
+		cb.successCount++
+		if cb.successCount >= cb.cfg.SuccessThreshold {
+			cb.transitionTo(CBClosed)
+		}
+		return
+	}
+
+	now := time.Now()
+	cb.outcomes = append(cb.outcomes, outcome{at: now, success: true})
+	cb.outcomes = pruneOutcomes(cb.outcomes, now, cb.cfg.Window)
+}
+
+func (cb *circuitBreaker) stats() CBStats {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return CBStats{
+		State:          cb.state,
+		FailureCount:   countFailures(cb.outcomes),
+		SuccessCount:   cb.successCount,
+		LastTransition: cb.lastTransition,
+	}
+}
+
+func pruneOutcomes(outcomes []outcome, now time.Time, window time.Duration) []outcome {
+	cutoff := now.Add(-window)
+	kept := outcomes[:0]
+	for _, o := range outcomes {
+		if o.at.After(cutoff) {
+			kept = append(kept, o)
+		}
+	}
+	return kept
+}
+
+func countFailures(outcomes []outcome) int {
+	count := 0
+	for _, o := range outcomes {
+		if !o.success {
+			count++
+		}
+	}
+	return count
+}
+
+// CircuitBreaker wraps an ActionHandler with a closed -> open -> half-open state machine:
+// once FailureThreshold failures land within Window the breaker opens and short-circuits
+// every call with ErrCircuitOpen, until CoolDown elapses and a half-open probe is allowed
+// through; SuccessThreshold consecutive half-open successes close it again, while a single
+// half-open failure reopens it. keyFn lets the breaker be keyed per request, e.g. per-tenant
+// or per-host when the wrapped gateway talks to multiple external services, so a separate
+// state machine is kept per key. Compose this above Retry so retries don't hammer a downed
+// dependency. Alongside the wrapped handler it returns a Stats function, keyed the same
+// way, so the breaker state can be surfaced on an HTTP endpoint.
+func CircuitBreaker[R any, S any](actionHandler core.ActionHandler[R, S], cfg CBConfig, keyFn func(request R) string) (core.ActionHandler[R, S], func() map[string]CBStats) {
+	var mu sync.Mutex
+	breakers := make(map[string]*circuitBreaker)
+
+	breakerFor := func(key string) *circuitBreaker {
+		mu.Lock()
+		defer mu.Unlock()
+
+		cb, ok := breakers[key]
+		if !ok {
+			cb = newCircuitBreaker(cfg)
+			breakers[key] = cb
+		}
+		return cb
+	}
+
+	handler := func(ctx context.Context, request R) (*S, error) {
+		cb := breakerFor(keyFn(request))
+
+		if !cb.allow() {
+			return nil, ErrCircuitOpen
+		}
+
+		response, err := actionHandler(ctx, request)
+		if err != nil {
+			cb.recordFailure()
+			return nil, err
+		}
+
+		cb.recordSuccess()
+
+		return response, nil
+	}
+
+	stats := func() map[string]CBStats {
+		mu.Lock()
+		defer mu.Unlock()
+
+		snapshot := make(map[string]CBStats, len(breakers))
+		for key, cb := range breakers {
+			snapshot[key] = cb.stats()
+		}
+		return snapshot
+	}
+
+	return handler, stats
+}
+
+// PublishCircuitBreakerStats registers a GET handler at apiURL that serves the current
+// state of every breaker tracked by stats as JSON, the same way ApiPrinter and
+// WSRoutePrinter publish their own metadata documents.
+func PublishCircuitBreakerStats(mux *http.ServeMux, apiURL string, stats func() map[string]CBStats) {
+	mux.HandleFunc("GET "+apiURL, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(stats())
+	})
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"project/core"
+	"time"
+)
+
+// Deadline wraps an ActionHandler so each invocation is bounded by an absolute deadline
+// computed from the request via deadlineFn, e.g. when a use case carries its own SLA on
+// the request struct. The handler runs in its own goroutine; if the deadline elapses first,
+// Deadline returns the context's DeadlineExceeded error and abandons the handler's eventual
+// result. Composing this above TransactionMiddleware ensures an aborted handler still rolls
+// back its transaction instead of leaving it open.
+func Deadline[R any, S any](actionHandler core.ActionHandler[R, S], deadlineFn func(ctx context.Context, request R) time.Time) core.ActionHandler[R, S] {
+	return func(ctx context.Context, request R) (*S, error) {
+		deadlineCtx, cancel := context.WithDeadline(ctx, deadlineFn(ctx, request))
+		defer cancel()
+
+		type result struct {
+			response *S
+			err      error
+		}
+
+		done := make(chan result, 1)
+
+		go func() {
+			response, err := actionHandler(deadlineCtx, request)
+			done <- result{response, err}
+		}()
+
+		select {
+		case res := <-done:
+			return res.response, res.err
+		case <-deadlineCtx.Done():
+			return nil, deadlineCtx.Err()
+		}
+	}
+}
+
+// Timeout wraps an ActionHandler so each invocation is aborted if it runs longer than dur,
+// so slow gateways (DB, HTTP, MQTT) are cut off deterministically instead of hanging. It is
+// a thin convenience over Deadline for the common relative-duration case.
+func Timeout[R any, S any](actionHandler core.ActionHandler[R, S], dur time.Duration) core.ActionHandler[R, S] {
+	return Deadline(actionHandler, func(ctx context.Context, request R) time.Time {
+		return time.Now().Add(dur)
+	})
+}
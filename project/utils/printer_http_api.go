@@ -146,6 +146,7 @@ func (r ApiPrinter) generateOpenAPISchema(baseURL string) OpenAPISchema {
 	}
 
 	uniqueTags := make(map[string]bool)
+	builder := newSchemaBuilder()
 
 	for _, endpoint := range r.urls {
 		path := endpoint.Url
@@ -224,7 +225,7 @@ func (r ApiPrinter) generateOpenAPISchema(baseURL string) OpenAPISchema {
 
 			if endpoint.Body != nil && method != "get" {
 
-				bodySchema := generateBodySchema(endpoint.Body)
+				bodySchema := builder.schemaFor(reflect.TypeOf(endpoint.Body))
 				operation["requestBody"] = map[string]any{
 					"content": map[string]any{
 						"application/json": map[string]any{
@@ -278,50 +279,8 @@ func (r ApiPrinter) generateOpenAPISchema(baseURL string) OpenAPISchema {
 		},
 	}
 
-	return schema
-}
-
-func generateBodySchema(body any) map[string]any {
-	return generateSchema(reflect.TypeOf(body))
-}
-
-func generateSchema(t reflect.Type) map[string]any {
-	schema := map[string]any{}
-
-	switch t.Kind() {
-	case reflect.Struct:
-		schema["type"] = "object"
-		properties := make(map[string]any)
-		for i := 0; i < t.NumField(); i++ {
-			field := t.Field(i)
-			jsonTag := field.Tag.Get("json")
-			if jsonTag == "" {
-				jsonTag = field.Name
-			}
-			jsonTag = strings.Split(jsonTag, ",")[0]
-
-			fieldSchema := generateSchema(field.Type)
-			properties[jsonTag] = fieldSchema
-		}
-		schema["properties"] = properties
-
-	case reflect.Slice:
-		schema["type"] = "array"
-		schema["items"] = generateSchema(t.Elem())
-
-	case reflect.Ptr:
-		return generateSchema(t.Elem())
-
-	case reflect.String:
-		schema["type"] = "string"
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		schema["type"] = "integer"
-	case reflect.Float32, reflect.Float64:
-		schema["type"] = "number"
-	case reflect.Bool:
-		schema["type"] = "boolean"
-	default:
-		schema["type"] = "object"
+	if len(builder.components) > 0 {
+		schema.Components["schemas"] = builder.components
 	}
 
 	return schema
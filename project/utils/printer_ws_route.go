@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// WSRouteData represents metadata about a registered WebSocket inbound message type,
+// mirroring APIData for HTTP routes and MQTTTopicData for MQTT topics.
+type WSRouteData struct {
+	Type        string
+	Tag         string
+	Summary     string
+	Description string
+	Payload     any
+}
+
+// WSRoutePrinter collects registered WebSocket message types and publishes their payload
+// schemas, analogous to ApiPrinter and MQTTTopicPrinter.
+type WSRoutePrinter struct {
+	routes []WSRouteData
+}
+
+// NewWSRoutePrinter creates a new WebSocket route printer.
+func NewWSRoutePrinter() *WSRoutePrinter {
+	return &WSRoutePrinter{routes: []WSRouteData{}}
+}
+
+// Add registers a WebSocket message type for documentation.
+func (p *WSRoutePrinter) Add(routeData WSRouteData) *WSRoutePrinter {
+	p.routes = append(p.routes, routeData)
+	return p
+}
+
+// PrintWSRouteTable prints a formatted table of registered WebSocket message types.
+func (p WSRoutePrinter) PrintWSRouteTable() WSRoutePrinter {
+	tagWidth := 20
+	typeWidth := 30
+	summaryWidth := 40
+
+	headerFormat := fmt.Sprintf("%%-%ds %%-%ds %%s\n", tagWidth, typeWidth)
+	fmt.Printf(headerFormat, "Tag", "Type", "Summary")
+	fmt.Println(strings.Repeat("-", tagWidth+typeWidth+summaryWidth+4))
+
+	rowFormat := fmt.Sprintf("%%-%ds %%-%ds %%s\n", tagWidth, typeWidth)
+	for _, route := range p.routes {
+		tag := TruncateOrPad(route.Tag, tagWidth)
+		msgType := TruncateOrPad(route.Type, typeWidth)
+		summary := TruncateOrPad(route.Summary, summaryWidth)
+
+		fmt.Printf(rowFormat, tag, msgType, summary)
+	}
+	fmt.Println()
+
+	return p
+}
+
+// generateWSDocsSchema builds the JSON document served at /api/ws-docs, using generateSchema
+// to inline each payload schema (no "$ref" components, unlike ApiPrinter's request bodies).
+func (p WSRoutePrinter) generateWSDocsSchema() map[string]any {
+	events := make([]map[string]any, 0, len(p.routes))
+
+	for _, route := range p.routes {
+		event := map[string]any{
+			"type": route.Type,
+			"tag":  route.Tag,
+		}
+
+		if route.Summary != "" {
+			event["summary"] = route.Summary
+		}
+		if route.Description != "" {
+			event["description"] = route.Description
+		}
+		if route.Payload != nil {
+			event["payload"] = generateSchema(reflect.TypeOf(route.Payload))
+		}
+
+		events = append(events, event)
+	}
+
+	return map[string]any{"ws_events": events}
+}
+
+// PublishWSDocs serves the WebSocket event contract document at apiURL.
+func (p WSRoutePrinter) PublishWSDocs(mux *http.ServeMux, baseURL, apiURL string) WSRoutePrinter {
+	handler := func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(p.generateWSDocsSchema())
+	}
+
+	mux.HandleFunc("GET "+apiURL, handler)
+
+	fmt.Printf("\nWS EVENT SCHEMA available at: %s%s\n", baseURL, apiURL)
+
+	return p
+}
@@ -0,0 +1,266 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// schemaBuilder turns Go types into OpenAPI 3.0 schema objects via reflection. When
+// useRefs is set, struct types are emitted once into components and referenced everywhere
+// else via "$ref" instead of being inlined repeatedly.
+type schemaBuilder struct {
+	useRefs    bool
+	components map[string]any
+
+	// typeNames tracks the component name already assigned to a struct type, keyed by
+	// reflect.Type rather than its bare name so that two distinct types which happen to
+	// share an unqualified name (e.g. two different "Address" structs) don't collide.
+	typeNames map[reflect.Type]string
+	usedNames map[string]bool
+}
+
+// newSchemaBuilder creates a builder that de-duplicates struct schemas into
+// components["schemas"] and rewrites repeat occurrences to "$ref".
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{
+		useRefs:    true,
+		components: make(map[string]any),
+		typeNames:  make(map[reflect.Type]string),
+		usedNames:  make(map[string]bool),
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaFor returns the OpenAPI schema for t, recursing into structs, slices, maps, and
+// pointers.
+func (b *schemaBuilder) schemaFor(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		return b.schemaForStruct(t)
+
+	case reflect.Slice, reflect.Array:
+		return map[string]any{
+			"type":  "array",
+			"items": b.schemaFor(t.Elem()),
+		}
+
+	case reflect.Map:
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": b.schemaFor(t.Elem()),
+		}
+
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	default:
+		return map[string]any{"type": "object"}
+	}
+}
+
+// schemaForStruct builds the schema for a named struct, registering it as a reusable
+// component (and returning a "$ref" to it) when the builder uses refs and the struct has a
+// name; anonymous structs are always inlined.
+func (b *schemaBuilder) schemaForStruct(t reflect.Type) map[string]any {
+	if !b.useRefs || t.Name() == "" {
+		return b.buildObjectSchema(t)
+	}
+
+	if name, ok := b.typeNames[t]; ok {
+		return map[string]any{"$ref": "#/components/schemas/" + name}
+	}
+
+	name := b.refNameFor(t)
+	b.typeNames[t] = name
+	b.usedNames[name] = true
+
+	// Reserve the name before recursing so self-referential / mutually
+	// referential structs don't recurse forever.
+	b.components[name] = map[string]any{}
+	b.components[name] = b.buildObjectSchema(t)
+
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+// refNameFor picks a component name for t, qualifying it with its package when the bare
+// type name is already taken by a different type.
+func (b *schemaBuilder) refNameFor(t reflect.Type) string {
+	name := t.Name()
+	if !b.usedNames[name] {
+		return name
+	}
+
+	if pkg := t.PkgPath(); pkg != "" {
+		if i := strings.LastIndexByte(pkg, '/'); i >= 0 {
+			pkg = pkg[i+1:]
+		}
+		if qualified := pkg + "_" + name; !b.usedNames[qualified] {
+			return qualified
+		}
+	}
+
+	for i := 2; ; i++ {
+		if candidate := fmt.Sprintf("%s_%d", name, i); !b.usedNames[candidate] {
+			return candidate
+		}
+	}
+}
+
+func (b *schemaBuilder) buildObjectSchema(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	var required []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" { // unexported field
+			continue
+		}
+
+		jsonTag, omit := jsonFieldName(field)
+		if omit {
+			continue
+		}
+
+		fieldSchema := b.schemaFor(field.Type)
+		applyFieldTags(fieldSchema, field)
+
+		if fieldIsRequired(field) {
+			required = append(required, jsonTag)
+		}
+
+		properties[jsonTag] = fieldSchema
+	}
+
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+// jsonFieldName derives a field's JSON property name from its `json` tag, falling back to
+// the Go field name. The second return value is true if the field is tagged `json:"-"` and
+// should be skipped entirely.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	jsonTag := field.Tag.Get("json")
+	if jsonTag == "" {
+		return field.Name, false
+	}
+
+	name := strings.Split(jsonTag, ",")[0]
+	if name == "-" {
+		return "", true
+	}
+	if name == "" {
+		return field.Name, false
+	}
+	return name, false
+}
+
+// fieldIsRequired reports whether field's `validate` tag includes the "required" rule.
+func fieldIsRequired(field reflect.StructField) bool {
+	for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+// applyFieldTags overlays a field's `validate`, `example`, `enum`, `format`, and
+// `description` struct tags onto its already-computed base schema.
+func applyFieldTags(schema map[string]any, field reflect.StructField) {
+	applyValidateTag(schema, field.Tag.Get("validate"))
+
+	if example := field.Tag.Get("example"); example != "" {
+		schema["example"] = example
+	}
+
+	if enum := field.Tag.Get("enum"); enum != "" {
+		values := strings.Split(enum, "|")
+		enumValues := make([]any, len(values))
+		for i, v := range values {
+			enumValues[i] = v
+		}
+		schema["enum"] = enumValues
+	}
+
+	if format := field.Tag.Get("format"); format != "" {
+		schema["format"] = format
+	}
+
+	if description := field.Tag.Get("description"); description != "" {
+		schema["description"] = description
+	}
+}
+
+// applyValidateTag translates a subset of `validate` rules (as used by
+// go-playground/validator) into the matching OpenAPI keywords: min/max become
+// minLength/maxLength for strings and minimum/maximum for numbers, and pattern becomes
+// the OpenAPI "pattern" regex keyword.
+func applyValidateTag(schema map[string]any, validateTag string) {
+	if validateTag == "" {
+		return
+	}
+
+	isString := schema["type"] == "string"
+
+	for _, rule := range strings.Split(validateTag, ",") {
+		name, value, hasValue := strings.Cut(rule, "=")
+		if !hasValue {
+			continue
+		}
+
+		switch name {
+		case "min":
+			if isString {
+				if n, err := strconv.Atoi(value); err == nil {
+					schema["minLength"] = n
+				}
+			} else if n, err := strconv.ParseFloat(value, 64); err == nil {
+				schema["minimum"] = n
+			}
+		case "max":
+			if isString {
+				if n, err := strconv.Atoi(value); err == nil {
+					schema["maxLength"] = n
+				}
+			} else if n, err := strconv.ParseFloat(value, 64); err == nil {
+				schema["maximum"] = n
+			}
+		case "pattern":
+			if isString {
+				schema["pattern"] = value
+			}
+		}
+	}
+}
+
+// generateSchema builds a standalone, fully-inlined schema for t, with no "$ref"
+// indirection. It is used by callers that publish a schema document of their own, such as
+// WSRoutePrinter, rather than sharing ApiPrinter's components section.
+func generateSchema(t reflect.Type) map[string]any {
+	return (&schemaBuilder{useRefs: false, components: make(map[string]any)}).schemaFor(t)
+}
@@ -0,0 +1,175 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"project/utils/schematest"
+)
+
+type schemaTestAddress struct {
+	City string `json:"city"`
+}
+
+type schemaTestUser struct {
+	Name    string             `json:"name" validate:"required,min=2,max=50,pattern=^[a-zA-Z ]+$"`
+	Age     int                `json:"age" validate:"min=0,max=130"`
+	Role    string             `json:"role" enum:"admin|member" example:"member" description:"the user's role"`
+	Home    schemaTestAddress  `json:"home"`
+	Other   *schemaTestAddress `json:"other,omitempty"`
+	Created time.Time          `json:"created"`
+	ignored string
+}
+
+func TestGenerateSchemaBasicFields(t *testing.T) {
+	schema := generateSchema(reflect.TypeOf(schemaTestUser{}))
+
+	if schema["type"] != "object" {
+		t.Fatalf("expected type object, got %v", schema["type"])
+	}
+
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected properties map, got %T", schema["properties"])
+	}
+
+	if _, ok := properties["ignored"]; ok {
+		t.Fatalf("unexported field should not be present in schema")
+	}
+
+	name, ok := properties["name"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected name property, got %v", properties["name"])
+	}
+	if name["type"] != "string" {
+		t.Errorf("expected name type string, got %v", name["type"])
+	}
+	if name["minLength"] != 2 {
+		t.Errorf("expected minLength 2, got %v", name["minLength"])
+	}
+	if name["maxLength"] != 50 {
+		t.Errorf("expected maxLength 50, got %v", name["maxLength"])
+	}
+	if name["pattern"] != "^[a-zA-Z ]+$" {
+		t.Errorf("expected pattern, got %v", name["pattern"])
+	}
+
+	age, ok := properties["age"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected age property, got %v", properties["age"])
+	}
+	if age["minimum"] != 0.0 {
+		t.Errorf("expected minimum 0, got %v", age["minimum"])
+	}
+	if age["maximum"] != 130.0 {
+		t.Errorf("expected maximum 130, got %v", age["maximum"])
+	}
+
+	role, ok := properties["role"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected role property, got %v", properties["role"])
+	}
+	if role["example"] != "member" {
+		t.Errorf("expected example member, got %v", role["example"])
+	}
+	if role["description"] != "the user's role" {
+		t.Errorf("expected description, got %v", role["description"])
+	}
+	enum, ok := role["enum"].([]any)
+	if !ok || len(enum) != 2 || enum[0] != "admin" || enum[1] != "member" {
+		t.Errorf("expected enum [admin member], got %v", role["enum"])
+	}
+
+	created, ok := properties["created"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected created property, got %v", properties["created"])
+	}
+	if created["type"] != "string" || created["format"] != "date-time" {
+		t.Errorf("expected time.Time to render as string/date-time, got %v", created)
+	}
+
+	required, ok := schema["required"].([]string)
+	if !ok || len(required) != 1 || required[0] != "name" {
+		t.Errorf("expected required [name], got %v", schema["required"])
+	}
+}
+
+func TestGenerateSchemaHasNoRefs(t *testing.T) {
+	schema := generateSchema(reflect.TypeOf(schemaTestUser{}))
+
+	properties := schema["properties"].(map[string]any)
+	home, ok := properties["home"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected home property, got %v", properties["home"])
+	}
+	if _, hasRef := home["$ref"]; hasRef {
+		t.Errorf("generateSchema should fully inline nested structs, got %v", home)
+	}
+	if home["type"] != "object" {
+		t.Errorf("expected inlined object schema, got %v", home["type"])
+	}
+}
+
+func TestSchemaBuilderDeduplicatesStructsWithRefs(t *testing.T) {
+	type pair struct {
+		A schemaTestAddress `json:"a"`
+		B schemaTestAddress `json:"b"`
+	}
+
+	builder := newSchemaBuilder()
+	schema := builder.buildObjectSchema(reflect.TypeOf(pair{}))
+
+	properties := schema["properties"].(map[string]any)
+	a, ok := properties["a"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected a property, got %v", properties["a"])
+	}
+	if a["$ref"] != "#/components/schemas/schemaTestAddress" {
+		t.Errorf("expected $ref to shared component, got %v", a)
+	}
+
+	if _, ok := builder.components["schemaTestAddress"]; !ok {
+		t.Errorf("expected schemaTestAddress to be registered as a component")
+	}
+}
+
+// Address shares its unqualified name with schematest.Address purely to exercise
+// schemaBuilder's cross-package collision handling; the two are otherwise unrelated.
+type Address struct {
+	City string `json:"city"`
+}
+
+func TestSchemaBuilderDisambiguatesSameNamedTypes(t *testing.T) {
+	builder := newSchemaBuilder()
+
+	localType := reflect.TypeOf(Address{})
+	otherType := reflect.TypeOf(schematest.Address{})
+
+	builder.schemaForStruct(localType)
+	builder.schemaForStruct(otherType)
+
+	localName, ok := builder.typeNames[localType]
+	if !ok {
+		t.Fatalf("expected utils.Address to be registered")
+	}
+	otherName, ok := builder.typeNames[otherType]
+	if !ok {
+		t.Fatalf("expected schematest.Address to be registered")
+	}
+
+	if localName == otherName {
+		t.Fatalf("expected distinct component names for same-named types from different packages, both got %q", localName)
+	}
+	if len(builder.components) != 2 {
+		t.Fatalf("expected both types to keep their own component entry, got %v", builder.components)
+	}
+
+	localComponent, ok := builder.components[localName].(map[string]any)
+	if !ok {
+		t.Fatalf("expected %q component to be a schema object, got %v", localName, builder.components[localName])
+	}
+	if _, ok := localComponent["properties"]; !ok {
+		t.Errorf("expected %q component to carry its own properties, got %v", localName, localComponent)
+	}
+}
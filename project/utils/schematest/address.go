@@ -0,0 +1,10 @@
+// Package schematest provides a fixture type that deliberately shares its unqualified
+// name with utils' own test fixtures, for exercising schemaBuilder's component-name
+// collision handling across packages.
+package schematest
+
+// Address is a distinct type from any "Address" declared in utils' own tests; only the
+// bare name collides.
+type Address struct {
+	Country string `json:"country"`
+}
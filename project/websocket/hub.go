@@ -0,0 +1,109 @@
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"project/core"
+	"project/gateway"
+
+	"github.com/gorilla/websocket"
+)
+
+// WSInboundReq is the request an ActionHandler receives for a single inbound WebSocket
+// frame, analogous to the HTTP body APIController handlers decode.
+type WSInboundReq struct {
+	SessionID string
+	Type      string
+	Payload   json.RawMessage
+}
+
+// WSOutboundRes is the response an ActionHandler returns for a single WebSocket frame.
+type WSOutboundRes struct {
+	Type    string `json:"type"`
+	Payload any    `json:"payload"`
+}
+
+// WSHandler is the handler type for an inbound WebSocket message. Because it is just an
+// instantiation of core.ActionHandler[R, S], the existing middleware.Logging and
+// middleware.Timing wrappers compose over it with no changes.
+type WSHandler = core.ActionHandler[WSInboundReq, WSOutboundRes]
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// Hub upgrades incoming HTTP connections to WebSocket sessions, registers them in a
+// SessionRegistry, and dispatches each inbound frame to the WSHandler registered for its
+// message Type.
+type Hub struct {
+	registry   *SessionRegistry
+	generateID gateway.GenerateID
+	routes     map[string]WSHandler
+}
+
+// NewHub creates a Hub backed by registry, using generateID to mint a session id per
+// connection.
+func NewHub(registry *SessionRegistry, generateID gateway.GenerateID) *Hub {
+	return &Hub{
+		registry:   registry,
+		generateID: generateID,
+		routes:     make(map[string]WSHandler),
+	}
+}
+
+// HandleFunc registers handler for inbound messages whose Type field equals msgType.
+func (h *Hub) HandleFunc(msgType string, handler WSHandler) {
+	h.routes[msgType] = handler
+}
+
+// Routes returns the registered message types, for use by WSRoutePrinter.
+func (h *Hub) Routes() map[string]WSHandler {
+	return h.routes
+}
+
+// ServeHTTP upgrades the request to a WebSocket connection, registers a session, and
+// reads frames from it until the connection closes.
+func (h *Hub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		http.Error(w, "failed to upgrade connection", http.StatusBadRequest)
+		return
+	}
+	defer conn.Close()
+
+	idRes, err := h.generateID(r.Context(), gateway.GenerateIDReq{Kind: gateway.IDKindXID})
+	if err != nil {
+		return
+	}
+
+	session := h.registry.Connect(idRes.ID, conn)
+	defer h.registry.Disconnect(session.ID)
+
+	for {
+		var req WSInboundReq
+		if err := conn.ReadJSON(&req); err != nil {
+			return
+		}
+		req.SessionID = session.ID
+
+		handler, ok := h.routes[req.Type]
+		if !ok {
+			_ = session.Send(WSOutboundRes{Type: "error", Payload: fmt.Sprintf("unknown message type %q", req.Type)})
+			continue
+		}
+
+		res, err := handler(r.Context(), req)
+		if err != nil {
+			log.Printf("websocket: handler for %q failed: %v", req.Type, err)
+			_ = session.Send(WSOutboundRes{Type: "error", Payload: err.Error()})
+			continue
+		}
+
+		if res != nil {
+			_ = session.Send(*res)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package websocket
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+)
+
+// Session represents a single live WebSocket connection, identified by an id minted
+// through the gateway.GenerateID gateway so it sorts and logs consistently with the
+// rest of the system.
+type Session struct {
+	ID   string
+	Conn *websocket.Conn
+
+	mu     sync.Mutex
+	topics map[string]bool
+
+	writeMu sync.Mutex
+}
+
+// Subscribe adds the session to a broadcast topic.
+func (s *Session) Subscribe(topic string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.topics[topic] = true
+}
+
+// Unsubscribe removes the session from a broadcast topic.
+func (s *Session) Unsubscribe(topic string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.topics, topic)
+}
+
+// subscribed reports whether the session is currently on topic.
+func (s *Session) subscribed(topic string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.topics[topic]
+}
+
+// Send writes an outbound message directly to this session's connection. It serializes
+// writes with writeMu since gorilla/websocket allows only one concurrent writer per
+// connection, and Send can be called both from the per-connection read loop and from
+// SessionRegistry.Broadcast running on another goroutine.
+func (s *Session) Send(res WSOutboundRes) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	return s.Conn.WriteJSON(res)
+}
+
+// ConnectHook is invoked once a session has been registered and is ready for traffic.
+type ConnectHook func(session *Session)
+
+// DisconnectHook is invoked once a session has been removed from the registry.
+type DisconnectHook func(session *Session)
+
+// SessionRegistry tracks every connected Session, keyed by id, and fans broadcasts out
+// to topic subscribers. It mirrors the connection bookkeeping of ApiPrinter/
+// MQTTTopicPrinter's registries but for live connections instead of static route metadata.
+type SessionRegistry struct {
+	mu       sync.RWMutex
+	sessions map[string]*Session
+	count    int64
+
+	onConnect    ConnectHook
+	onDisconnect DisconnectHook
+}
+
+// NewSessionRegistry creates an empty registry. onConnect/onDisconnect may be nil.
+func NewSessionRegistry(onConnect ConnectHook, onDisconnect DisconnectHook) *SessionRegistry {
+	return &SessionRegistry{
+		sessions:     make(map[string]*Session),
+		onConnect:    onConnect,
+		onDisconnect: onDisconnect,
+	}
+}
+
+// Connect registers a new session under id and runs the connect hook, if any.
+func (r *SessionRegistry) Connect(id string, conn *websocket.Conn) *Session {
+	session := &Session{ID: id, Conn: conn, topics: make(map[string]bool)}
+
+	r.mu.Lock()
+	r.sessions[id] = session
+	r.mu.Unlock()
+
+	atomic.AddInt64(&r.count, 1)
+
+	if r.onConnect != nil {
+		r.onConnect(session)
+	}
+
+	return session
+}
+
+// Disconnect removes the session identified by id and runs the disconnect hook, if any.
+func (r *SessionRegistry) Disconnect(id string) {
+	r.mu.Lock()
+	session, ok := r.sessions[id]
+	if ok {
+		delete(r.sessions, id)
+	}
+	r.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	atomic.AddInt64(&r.count, -1)
+
+	if r.onDisconnect != nil {
+		r.onDisconnect(session)
+	}
+}
+
+// Count returns the number of currently connected sessions.
+func (r *SessionRegistry) Count() int64 {
+	return atomic.LoadInt64(&r.count)
+}
+
+// Get returns the session identified by id, if it is still connected.
+func (r *SessionRegistry) Get(id string) (*Session, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	session, ok := r.sessions[id]
+	return session, ok
+}
+
+// Broadcast sends res to every session subscribed to topic, skipping write errors on
+// individual sessions rather than aborting the whole broadcast.
+func (r *SessionRegistry) Broadcast(topic string, res WSOutboundRes) {
+	r.mu.RLock()
+	recipients := make([]*Session, 0, len(r.sessions))
+	for _, session := range r.sessions {
+		if session.subscribed(topic) {
+			recipients = append(recipients, session)
+		}
+	}
+	r.mu.RUnlock()
+
+	for _, session := range recipients {
+		_ = session.Send(res)
+	}
+}
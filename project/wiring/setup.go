@@ -7,7 +7,7 @@ import (
 	"gorm.io/gorm"
 )
 
-func SetupWiring(mux *http.ServeMux, db *gorm.DB, apiPrinter *utils.ApiPrinter) {
+func SetupWiring(mux *http.ServeMux, db *gorm.DB, apiPrinter *utils.ApiPrinter, wsRoutePrinter *utils.WSRoutePrinter) {
 
 	// Wire up the Todo components
 
@@ -15,9 +15,23 @@ func SetupWiring(mux *http.ServeMux, db *gorm.DB, apiPrinter *utils.ApiPrinter)
 	// someGateway1 := gateway.ImpSomeGateway1(sc1)
 	// someGateway2 := gateway.ImpSomeGateway2(sc1)
 	// someGateway3 := gateway.ImpSomeGateway3(sc1)
+	// generateID := gateway.ImplGenerateIDComposite(map[gateway.IDKind]gateway.GenerateID{
+	// 	gateway.IDKindUUIDv4:    gateway.ImplGenerateIDUUIDv4(),
+	// 	gateway.IDKindUUIDv7:    gateway.ImplGenerateIDUUIDv7(),
+	// 	gateway.IDKindXID:       gateway.ImplGenerateIDXID(),
+	// 	gateway.IDKindULID:      gateway.ImplGenerateIDULID(),
+	// 	gateway.IDKindSnowflake: gateway.ImplGenerateIDSnowflake(1),
+	// })
 
 	// Initialize middleware
 	// someGatewayUnderMiddleware := middleware.ImpSomeMiddleware(someGateway3)
+	// someGatewayWithRetry := middleware.Retry(someGateway3, 3)
+	// someGatewayWithBreaker, someGatewayBreakerStats := middleware.CircuitBreaker(
+	// 	someGatewayWithRetry,
+	// 	middleware.CBConfig{FailureThreshold: 5, Window: time.Minute, CoolDown: 30 * time.Second, SuccessThreshold: 2},
+	// 	func(req SomeGatewayReq) string { return "default" },
+	// )
+	// middleware.PublishCircuitBreakerStats(mux, "/debug/circuit-breakers", someGatewayBreakerStats)
 
 	// Initialize usecases
 	// someUseCase := usecase.ImplSomeUseCase(
@@ -29,4 +43,14 @@ func SetupWiring(mux *http.ServeMux, db *gorm.DB, apiPrinter *utils.ApiPrinter)
 	// Initialize controllers
 	// controller.SomeController(sc2, someUseCase)
 
+	// Wire up the WebSocket hub
+	// registry := websocket.NewSessionRegistry(nil, nil)
+	// hub := websocket.NewHub(registry, generateID)
+	// hub.HandleFunc("ping", middleware.Logging(someWSHandler, 0))
+	// mux.Handle("/ws", hub)
+	// for msgType, handler := range hub.Routes() {
+	// 	_ = handler
+	// 	wsRoutePrinter.Add(utils.WSRouteData{Type: msgType, Tag: "Todo"})
+	// }
+
 }